@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/tj-actions/auto-doc/internal"
+	"github.com/tj-actions/auto-doc/internal/types"
+)
+
+var (
+	allRoot     string
+	allInclude  []string
+	allExclude  []string
+	allCombined bool
+)
+
+var allCmd = &cobra.Command{
+	Use:   "all",
+	Short: "Document every action.yml/action.yaml and reusable workflow under --root",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		actions, discoverErr := types.Discover(allRoot, types.DiscoverOptions{
+			Include: allInclude,
+			Exclude: allExclude,
+		})
+
+		var errs []error
+		if discoverErr != nil {
+			errs = append(errs, discoverErr)
+		}
+
+		if allCombined {
+			return errors.Join(append(errs, writeCombinedIndex(actions))...)
+		}
+
+		for _, action := range actions {
+			action.OutputFileName = outputFileNameFor(action, allRoot)
+			action.ColMaxWidth = colMaxWidth
+			action.ColMaxWords = colMaxWords
+			action.InputColumns = inputColumns
+			action.OutputColumns = outputColumns
+			action.SecretColumns = secretColumns
+			action.Format = format
+			action.EmitSchema = emitSchema
+
+			if err := action.RenderOutput(); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", action.InputFileName, err))
+			}
+		}
+
+		return errors.Join(errs...)
+	},
+}
+
+// outputFileNameFor picks where a discovered action's documentation is
+// written. action.yml/action.yaml have a colocated README, but a reusable
+// workflow under .github/workflows has no README of its own, so its docs go
+// to the repo-root README instead.
+func outputFileNameFor(action *types.Action, root string) string {
+	if action.Kind == types.KindReusableWorkflow {
+		return filepath.Join(root, outputFileName)
+	}
+
+	return filepath.Join(filepath.Dir(action.InputFileName), outputFileName)
+}
+
+func init() {
+	allCmd.Flags().StringVar(&allRoot, "root", ".", "Root directory to walk for action.yml/action.yaml and reusable workflow files")
+	allCmd.Flags().StringSliceVar(&allInclude, "include", nil, "Glob patterns (relative to --root) a file must match to be documented")
+	allCmd.Flags().StringSliceVar(&allExclude, "exclude", nil, "Glob patterns (relative to --root) that exclude a file from being documented")
+	allCmd.Flags().BoolVar(&allCombined, "combined", false, "Render a single index document linking every discovered action instead of updating each one's README.md in place")
+	allCmd.Flags().StringVar(&outputFileName, "output", "README.md", "Name of the file auto-doc should write generated docs to, colocated with each discovered action (or under --root for reusable workflows/--combined)")
+	allCmd.Flags().StringVar(&colMaxWidth, "col-max-width", "40", "Maximum width of a rendered table column")
+	allCmd.Flags().StringVar(&colMaxWords, "col-max-words", "80", "Maximum number of words before a column wraps")
+	allCmd.Flags().StringSliceVar(&inputColumns, "input-columns", internal.DefaultActionInputColumns, "Columns to render in the inputs table")
+	allCmd.Flags().StringSliceVar(&outputColumns, "output-columns", internal.DefaultActionOutputColumns, "Columns to render in the outputs table")
+	allCmd.Flags().StringSliceVar(&secretColumns, "secret-columns", internal.DefaultSecretColumns, "Columns to render in the secrets table (reusable workflows only)")
+	allCmd.Flags().StringVar(&format, "format", "markdown", "Output format: markdown, json, html, asciidoc, or mdx. Non-markdown formats are written to a sibling file instead of each action's OutputFileName")
+	allCmd.Flags().BoolVar(&emitSchema, "emit-schema", false, "Also write a JSON Schema document for each action's inputs to <output minus its extension>.schema.json, and splice it into OutputFileName if AUTO-DOC-SCHEMA markers are present")
+	rootCmd.AddCommand(allCmd)
+}
+
+// writeCombinedIndex renders a single markdown table listing every
+// discovered action, labelled with its directory and linking to its
+// action.yml/workflow file, and writes it to
+// OutputFileName under --root.
+func writeCombinedIndex(actions []*types.Action) error {
+	var sb strings.Builder
+
+	sb.WriteString("## Actions\n\n")
+	sb.WriteString("| Action | Inputs | Outputs |\n")
+	sb.WriteString("|---|---|---|\n")
+
+	for _, action := range actions {
+		dir := filepath.Dir(action.InputFileName)
+		fmt.Fprintf(&sb, "| [%s](%s) | %d | %d |\n", dir, action.InputFileName, len(action.Inputs), len(action.Outputs))
+	}
+
+	return os.WriteFile(filepath.Join(allRoot, outputFileName), []byte(sb.String()), 0666)
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tj-actions/auto-doc/internal/types"
+)
+
+func TestOutputFileNameFor(t *testing.T) {
+	outputFileName = "README.md"
+	root := "/repo"
+
+	action := &types.Action{InputFileName: "/repo/some-action/action.yml", Kind: types.KindAction}
+	if got, want := outputFileNameFor(action, root), filepath.Join("/repo/some-action", "README.md"); got != want {
+		t.Errorf("outputFileNameFor(action) = %q, want %q", got, want)
+	}
+
+	workflow := &types.Action{InputFileName: "/repo/.github/workflows/ci.yml", Kind: types.KindReusableWorkflow}
+	if got, want := outputFileNameFor(workflow, root), filepath.Join(root, "README.md"); got != want {
+		t.Errorf("outputFileNameFor(workflow) = %q, want %q", got, want)
+	}
+}
+
+func TestAllCmdPropagatesFormatAndEmitSchema(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "action.yml"), []byte("inputs:\n  foo:\n    description: a foo input\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("# Example\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	allRoot = dir
+	allInclude = nil
+	allExclude = nil
+	allCombined = false
+	outputFileName = "README.md"
+	colMaxWidth = "40"
+	colMaxWords = "80"
+	inputColumns = []string{"Input"}
+	outputColumns = []string{"Output"}
+	secretColumns = []string{"Secret"}
+	format = "json"
+	emitSchema = true
+
+	if err := allCmd.RunE(allCmd, nil); err != nil {
+		t.Fatalf("allCmd.RunE() error = %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "README.md.json")); err != nil {
+		t.Errorf("expected README.md.json to be written: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "README.schema.json")); err != nil {
+		t.Errorf("expected README.schema.json to be written with --emit-schema: %v", err)
+	}
+}
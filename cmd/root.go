@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/tj-actions/auto-doc/internal"
+	"github.com/tj-actions/auto-doc/internal/types"
+)
+
+var (
+	inputFileName  string
+	outputFileName string
+	colMaxWidth    string
+	colMaxWords    string
+	inputColumns   []string
+	outputColumns  []string
+	secretColumns  []string
+	format         string
+	check          bool
+	emitSchema     bool
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "auto-doc",
+	Short: "Automatically generate documentation for your GitHub Actions",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		action := &types.Action{
+			InputFileName:  inputFileName,
+			OutputFileName: outputFileName,
+			ColMaxWidth:    colMaxWidth,
+			ColMaxWords:    colMaxWords,
+			InputColumns:   inputColumns,
+			OutputColumns:  outputColumns,
+			SecretColumns:  secretColumns,
+			Format:         format,
+			EmitSchema:     emitSchema,
+		}
+
+		if err := action.GetData(); err != nil {
+			return err
+		}
+
+		if check {
+			diff, err := action.Check()
+			if err != nil {
+				return err
+			}
+
+			if diff != "" {
+				fmt.Fprint(cmd.OutOrStdout(), diff)
+				return fmt.Errorf("%s is out of date, run auto-doc to regenerate it", outputFileName)
+			}
+
+			return nil
+		}
+
+		return action.RenderOutput()
+	},
+}
+
+func init() {
+	rootCmd.Flags().StringVar(&inputFileName, "input", "action.yml", "Path to the action.yml or action.yaml file")
+	rootCmd.Flags().StringVar(&outputFileName, "output", "README.md", "Path to the file auto-doc should write generated docs to")
+	rootCmd.Flags().StringVar(&colMaxWidth, "col-max-width", "40", "Maximum width of a rendered table column")
+	rootCmd.Flags().StringVar(&colMaxWords, "col-max-words", "80", "Maximum number of words before a column wraps")
+	rootCmd.Flags().StringSliceVar(&inputColumns, "input-columns", internal.DefaultActionInputColumns, "Columns to render in the inputs table")
+	rootCmd.Flags().StringSliceVar(&outputColumns, "output-columns", internal.DefaultActionOutputColumns, "Columns to render in the outputs table")
+	rootCmd.Flags().StringSliceVar(&secretColumns, "secret-columns", internal.DefaultSecretColumns, "Columns to render in the secrets table (reusable workflows only)")
+	rootCmd.Flags().StringVar(&format, "format", "markdown", "Output format: markdown, json, html, asciidoc, or mdx. Non-markdown formats are written to a sibling file instead of OutputFileName")
+	rootCmd.Flags().BoolVar(&check, "check", false, "Check whether the generated documentation is up to date instead of writing it, exiting non-zero on drift")
+	rootCmd.Flags().BoolVar(&emitSchema, "emit-schema", false, "Also write a JSON Schema document for the action's inputs to <output minus its extension>.schema.json, and splice it into OutputFileName if AUTO-DOC-SCHEMA markers are present")
+}
+
+// Execute runs the root auto-doc command.
+func Execute() error {
+	return rootCmd.Execute()
+}
@@ -0,0 +1,34 @@
+package internal
+
+// Markers used to splice generated tables into README.md (or any other
+// OutputFileName) between known header lines and AUTO-DOC end comments.
+const (
+	InputsHeader  = "## Inputs"
+	OutputsHeader = "## Outputs"
+
+	InputAutoDocStart  = "<!-- AUTO-DOC-INPUT:START -->"
+	InputAutoDocEnd    = "<!-- AUTO-DOC-INPUT:END -->"
+	OutputAutoDocStart = "<!-- AUTO-DOC-OUTPUT:START -->"
+	OutputAutoDocEnd   = "<!-- AUTO-DOC-OUTPUT:END -->"
+
+	SecretsHeader      = "## Secrets"
+	SecretAutoDocStart = "<!-- AUTO-DOC-SECRET:START -->"
+	SecretAutoDocEnd   = "<!-- AUTO-DOC-SECRET:END -->"
+
+	SchemaAutoDocStart = "<!-- AUTO-DOC-SCHEMA:START -->"
+	SchemaAutoDocEnd   = "<!-- AUTO-DOC-SCHEMA:END -->"
+
+	PipeSeparator    = "|"
+	NewLineSeparator = "\n"
+)
+
+// DefaultActionInputColumns/DefaultActionOutputColumns are the columns
+// rendered when --input-columns/--output-columns are not overridden.
+var (
+	DefaultActionInputColumns  = []string{"Input", "Type", "Description", "Default", "Required"}
+	DefaultActionOutputColumns = []string{"Output", "Type", "Description"}
+
+	// DefaultSecretColumns are the columns rendered for reusable workflow
+	// secrets (on.workflow_call.secrets) when --secret-columns isn't set.
+	DefaultSecretColumns = []string{"Secret", "Required", "Description"}
+)
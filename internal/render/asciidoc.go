@@ -0,0 +1,33 @@
+package render
+
+import "strings"
+
+// asciiDocRenderer renders a table using AsciiDoc's [cols=...] table syntax.
+type asciiDocRenderer struct{}
+
+func (r asciiDocRenderer) Render(cols []string, rows [][]string) (string, error) {
+	out := &strings.Builder{}
+
+	if len(cols) == 0 {
+		out.WriteString("|===\n|===")
+		return out.String(), nil
+	}
+
+	out.WriteString("[cols=\"" + strings.Repeat("1,", len(cols)-1) + "1\",options=\"header\"]\n|===\n")
+
+	for _, col := range cols {
+		out.WriteString("| " + col + " ")
+	}
+	out.WriteString("\n\n")
+
+	for _, row := range rows {
+		for _, cell := range row {
+			out.WriteString("| " + strings.ReplaceAll(cell, "\n", " ") + " ")
+		}
+		out.WriteString("\n")
+	}
+
+	out.WriteString("|===")
+
+	return out.String(), nil
+}
@@ -0,0 +1,32 @@
+package render
+
+import (
+	"html"
+	"strings"
+)
+
+// htmlRenderer renders a table as a plain <table> element, suitable for
+// embedding in a static site generator's page template.
+type htmlRenderer struct{}
+
+func (r htmlRenderer) Render(cols []string, rows [][]string) (string, error) {
+	out := &strings.Builder{}
+
+	out.WriteString("<table>\n  <thead>\n    <tr>\n")
+	for _, col := range cols {
+		out.WriteString("      <th>" + html.EscapeString(col) + "</th>\n")
+	}
+	out.WriteString("    </tr>\n  </thead>\n  <tbody>\n")
+
+	for _, row := range rows {
+		out.WriteString("    <tr>\n")
+		for _, cell := range row {
+			out.WriteString("      <td>" + html.EscapeString(cell) + "</td>\n")
+		}
+		out.WriteString("    </tr>\n")
+	}
+
+	out.WriteString("  </tbody>\n</table>")
+
+	return out.String(), nil
+}
@@ -0,0 +1,28 @@
+package render
+
+import "encoding/json"
+
+// jsonRenderer renders a table as a JSON array of objects keyed by column
+// name, one object per row.
+type jsonRenderer struct{}
+
+func (r jsonRenderer) Render(cols []string, rows [][]string) (string, error) {
+	records := make([]map[string]string, 0, len(rows))
+
+	for _, row := range rows {
+		record := make(map[string]string, len(cols))
+		for i, col := range cols {
+			if i < len(row) {
+				record[col] = row[i]
+			}
+		}
+		records = append(records, record)
+	}
+
+	out, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(out), nil
+}
@@ -0,0 +1,83 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+
+	"github.com/tj-actions/auto-doc/internal"
+)
+
+// markdownRenderer renders a GitHub-flavored markdown table, matching the
+// tablewriter output auto-doc has always spliced into README.md.
+type markdownRenderer struct {
+	maxWidth int
+}
+
+func (r markdownRenderer) Render(cols []string, rows [][]string) (string, error) {
+	out := &strings.Builder{}
+
+	table := tablewriter.NewWriter(out)
+	table.SetHeader(cols)
+	table.SetBorders(tablewriter.Border{Left: true, Top: false, Right: true, Bottom: false})
+	table.SetCenterSeparator(internal.PipeSeparator)
+	table.SetAlignment(tablewriter.ALIGN_CENTER)
+	table.SetColWidth(r.maxWidth)
+
+	for _, row := range rows {
+		table.Append(decorateDefaultColumn(cols, row))
+	}
+
+	table.Render()
+
+	return out.String(), nil
+}
+
+// decorateDefaultColumn returns a copy of row with its Default cell (if any)
+// wrapped in the markdown syntax auto-doc has always spliced into README.md:
+// backtick-quoted for a single-line value, or `"line"`<br>-joined for a
+// multiline one. Other renderers receive the Default cell raw, so the
+// decoration only applies here.
+func decorateDefaultColumn(cols []string, row []string) []string {
+	idx := -1
+	for i, col := range cols {
+		if col == "Default" {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 || idx >= len(row) || row[idx] == "" {
+		return row
+	}
+
+	decorated := make([]string, len(row))
+	copy(decorated, row)
+	decorated[idx] = formatMarkdownDefault(row[idx])
+	return decorated
+}
+
+// formatMarkdownDefault applies the markdown-specific quoting/<br>-joining
+// that used to live in buildActionInputRows, moved here so non-markdown
+// formats (json, html, asciidoc) get the Default value raw.
+func formatMarkdownDefault(value string) string {
+	parts := strings.Split(value, "\n")
+
+	if len(parts) > 1 && value != internal.NewLineSeparator {
+		var joined strings.Builder
+		for _, part := range parts {
+			if part != "" {
+				joined.WriteString("`\"" + part + "\"`" + "<br>")
+			}
+		}
+		return joined.String()
+	}
+
+	if strings.Contains(value, internal.PipeSeparator) {
+		value = strings.Replace(value, internal.PipeSeparator, "\"\\"+internal.PipeSeparator+"\"", -1)
+	} else {
+		value = fmt.Sprintf("%#v", value)
+	}
+
+	return "`" + value + "`"
+}
@@ -0,0 +1,7 @@
+package render
+
+// mdxRenderer renders a plain markdown table. MDX is a strict superset of
+// markdown, so a standard GFM table is valid MDX as-is.
+type mdxRenderer struct {
+	markdownRenderer
+}
@@ -0,0 +1,66 @@
+package render
+
+import "fmt"
+
+// Format identifies a registered output Renderer.
+type Format string
+
+const (
+	FormatMarkdown Format = "markdown"
+	FormatJSON     Format = "json"
+	FormatHTML     Format = "html"
+	FormatAsciiDoc Format = "asciidoc"
+	FormatMDX      Format = "mdx"
+)
+
+// Renderer turns a table of column headers and rows into a serialized
+// document fragment for a single output format.
+type Renderer interface {
+	Render(cols []string, rows [][]string) (string, error)
+}
+
+// New returns the Renderer registered for format. maxWidth is only honoured
+// by the markdown and mdx renderers, where it controls tablewriter column
+// wrapping.
+func New(format Format, maxWidth int) (Renderer, error) {
+	switch format {
+	case "", FormatMarkdown:
+		return markdownRenderer{maxWidth: maxWidth}, nil
+	case FormatJSON:
+		return jsonRenderer{}, nil
+	case FormatHTML:
+		return htmlRenderer{}, nil
+	case FormatAsciiDoc:
+		return asciiDocRenderer{}, nil
+	case FormatMDX:
+		return mdxRenderer{markdownRenderer{maxWidth: maxWidth}}, nil
+	default:
+		return nil, fmt.Errorf(
+			"unknown format: %q. Please specify one of: markdown, json, html, asciidoc, mdx",
+			format,
+		)
+	}
+}
+
+// IsSpliced reports whether format is injected between AUTO-DOC markers in
+// OutputFileName (markdown) rather than written to a sibling file.
+func IsSpliced(format Format) bool {
+	return format == "" || format == FormatMarkdown
+}
+
+// SiblingFileName returns the file name auto-doc should write format's
+// rendered document to when it isn't spliced into OutputFileName.
+func SiblingFileName(base string, format Format) string {
+	switch format {
+	case FormatJSON:
+		return base + ".json"
+	case FormatHTML:
+		return base + ".html"
+	case FormatAsciiDoc:
+		return base + ".adoc"
+	case FormatMDX:
+		return base + ".mdx"
+	default:
+		return ""
+	}
+}
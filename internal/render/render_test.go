@@ -0,0 +1,110 @@
+package render
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewMDXHonoursMaxWidth(t *testing.T) {
+	r, err := New(FormatMDX, 5)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	mdx, ok := r.(mdxRenderer)
+	if !ok {
+		t.Fatalf("New(FormatMDX, ...) = %T, want mdxRenderer", r)
+	}
+
+	if mdx.maxWidth != 5 {
+		t.Errorf("mdxRenderer.maxWidth = %d, want 5", mdx.maxWidth)
+	}
+}
+
+func TestNewUnknownFormat(t *testing.T) {
+	if _, err := New(Format("bogus"), 40); err == nil {
+		t.Fatal("New() with an unknown format: expected an error, got nil")
+	}
+}
+
+func TestJSONRendererRender(t *testing.T) {
+	out, err := jsonRenderer{}.Render([]string{"Input", "Default"}, [][]string{{"foo", "line1\nline2"}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	if !strings.Contains(out, `"Input": "foo"`) {
+		t.Errorf("Render() = %q, want it to contain the Input cell raw", out)
+	}
+	if !strings.Contains(out, "line1\\nline2") {
+		t.Errorf("Render() = %q, want the Default cell raw (no markdown decoration)", out)
+	}
+}
+
+func TestJSONRendererRenderEmptyRows(t *testing.T) {
+	out, err := jsonRenderer{}.Render([]string{"Input"}, nil)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if strings.TrimSpace(out) != "[]" {
+		t.Errorf("Render() with no rows = %q, want %q", out, "[]")
+	}
+}
+
+func TestHTMLRendererRenderEscapesCells(t *testing.T) {
+	out, err := htmlRenderer{}.Render([]string{"Input"}, [][]string{{"<script>"}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if strings.Contains(out, "<script>") {
+		t.Errorf("Render() = %q, want the cell HTML-escaped", out)
+	}
+	if !strings.Contains(out, "&lt;script&gt;") {
+		t.Errorf("Render() = %q, want an escaped <script> cell", out)
+	}
+}
+
+func TestHTMLRendererRenderColumnRowMismatch(t *testing.T) {
+	out, err := htmlRenderer{}.Render([]string{"Input", "Default"}, [][]string{{"foo"}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if strings.Count(out, "<td>") != 1 {
+		t.Errorf("Render() with a short row = %q, want exactly one <td> cell", out)
+	}
+}
+
+func TestAsciiDocRendererRender(t *testing.T) {
+	out, err := asciiDocRenderer{}.Render([]string{"Input", "Default"}, [][]string{{"foo", "line1\nline2"}})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if !strings.Contains(out, `[cols="1,1",options="header"]`) {
+		t.Errorf("Render() = %q, want a [cols=...] header matching the column count", out)
+	}
+	if strings.Contains(out, "\nline2") {
+		t.Errorf("Render() = %q, want embedded newlines flattened to spaces", out)
+	}
+}
+
+func TestAsciiDocRendererRenderEmptyRows(t *testing.T) {
+	out, err := asciiDocRenderer{}.Render([]string{"Input"}, nil)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if !strings.Contains(out, "|===") {
+		t.Errorf("Render() with no rows = %q, want the table delimiters still present", out)
+	}
+}
+
+func TestAsciiDocRendererRenderEmptyCols(t *testing.T) {
+	// cobra turns a flag value like "--secret-columns=" into []string{},
+	// so an empty cols slice must not panic strings.Repeat's negative count.
+	out, err := asciiDocRenderer{}.Render(nil, nil)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if !strings.Contains(out, "|===") {
+		t.Errorf("Render() with no columns = %q, want the table delimiters still present", out)
+	}
+}
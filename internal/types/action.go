@@ -2,13 +2,15 @@ package types
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
-	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 	"github.com/tj-actions/auto-doc/internal"
+	"github.com/tj-actions/auto-doc/internal/render"
 	"github.com/tj-actions/auto-doc/internal/utils"
 	"gopkg.in/yaml.v3"
 	"os"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -19,6 +21,34 @@ type ActionInput struct {
 	Description string `yaml:"description"`
 	Required    bool   `yaml:"required"`
 	Default     string `yaml:"default,omitempty"`
+	// Options is a non-standard but widely adopted convention for
+	// documenting the allowed values of an input, rendered as a
+	// choice<a|b|c> type.
+	Options []string `yaml:"options,omitempty"`
+	// DeprecationMessage is a non-standard convention some actions use to
+	// flag an input as deprecated, surfaced via the Deprecation column.
+	DeprecationMessage string `yaml:"deprecationMessage,omitempty"`
+}
+
+// inferInputType derives a richer Type column value from an input's Default
+// and Options, since action.yml itself has no native input type system.
+func inferInputType(i ActionInput) string {
+	switch {
+	case len(i.Options) > 0:
+		return fmt.Sprintf("choice<%s>", strings.Join(i.Options, "|"))
+	case strings.Contains(i.Default, "\n"):
+		return "multiline"
+	case i.Default == "true" || i.Default == "false":
+		return "boolean"
+	}
+
+	if i.Default != "" {
+		if _, err := strconv.ParseFloat(i.Default, 64); err == nil {
+			return "number"
+		}
+	}
+
+	return "string"
 }
 
 // ActionOutput represents the output of the action.yml
@@ -27,65 +57,172 @@ type ActionOutput struct {
 	Value       string `yaml:"default,omitempty"`
 }
 
+// ActionSecret represents a secret accepted by a reusable workflow
+// (on.workflow_call.secrets). Plain action.yml files have no secrets
+// section, so this is only populated when InputFileName is a reusable
+// workflow file.
+type ActionSecret struct {
+	Description string `yaml:"description"`
+	Required    bool   `yaml:"required"`
+}
+
 // Action represents the action.yml
 type Action struct {
-	InputFileName string
+	InputFileName  string
 	OutputFileName string
-	ColMaxWidth string
-	ColMaxWords string
-	InputColumns []string
-	OutputColumns []string
-	Inputs  map[string]ActionInput  `yaml:"inputs,omitempty"`
-	Outputs map[string]ActionOutput `yaml:"outputs,omitempty"`
+	ColMaxWidth    string
+	ColMaxWords    string
+	InputColumns   []string
+	OutputColumns  []string
+	SecretColumns  []string
+	// Format selects the Renderer used for the input/output tables. It
+	// defaults to markdown, which is spliced into OutputFileName between the
+	// AUTO-DOC markers; any other format is written to a sibling file
+	// instead (see render.SiblingFileName).
+	Format string
+	// EmitSchema, when true, additionally writes a Draft 2020-12 JSON
+	// Schema document for Inputs to a sibling .schema.json file named after
+	// OutputFileName minus its extension (README.md -> README.schema.json) and
+	// splices it as a fenced json block between AUTO-DOC-SCHEMA markers in
+	// OutputFileName, if present.
+	EmitSchema bool
+	Inputs     map[string]ActionInput  `yaml:"inputs,omitempty"`
+	Outputs    map[string]ActionOutput `yaml:"outputs,omitempty"`
+	// Secrets is only populated for a reusable workflow's
+	// on.workflow_call.secrets; action.yml has no secrets section.
+	Secrets map[string]ActionSecret `yaml:"-"`
+	// Kind records whether InputFileName was detected as an action.yml or a
+	// reusable workflow file. Set by GetData; callers that need to treat
+	// the two differently (e.g. "auto-doc all" picking an output target)
+	// can read it afterwards.
+	Kind FileKind `yaml:"-"`
 }
 
 func (a *Action) GetData() error {
-	actionYaml, err := os.ReadFile(a.InputFileName)
+	raw, err := os.ReadFile(a.InputFileName)
+	if err != nil {
+		return err
+	}
+
+	kind, err := DetectFileKind(raw)
 	if err != nil {
 		return err
 	}
+	a.Kind = kind
+
+	if kind == KindReusableWorkflow {
+		return a.loadReusableWorkflowData(raw)
+	}
 
-	err = yaml.Unmarshal(actionYaml, &a)
-	return err
+	return yaml.Unmarshal(raw, a)
 }
 
-func (a *Action) WriteDocumentation(inputTable, outputTable *strings.Builder) error {
-	input, err := os.ReadFile(a.OutputFileName)
+// loadReusableWorkflowData maps on.workflow_call.inputs/outputs from a
+// reusable workflow file onto Inputs/Outputs, so a callable workflow can be
+// documented through the same RenderOutput/WriteDocumentation flow as an
+// action.yml.
+func (a *Action) loadReusableWorkflowData(raw []byte) error {
+	var workflow struct {
+		On WorkflowOn `yaml:"on"`
+	}
 
-	if err != nil {
+	if err := yaml.Unmarshal(raw, &workflow); err != nil {
 		return err
 	}
 
-	var output []byte
+	a.Inputs = make(map[string]ActionInput, len(workflow.On.WorkflowCall.Inputs))
+	for name, input := range workflow.On.WorkflowCall.Inputs {
+		a.Inputs[name] = ActionInput{
+			Description: input.Description,
+			Required:    input.Required,
+			Default:     input.Default,
+		}
+	}
 
-	hasInputsData, inputStartIndex, inputEndIndex := utils.HasBytesInBetween(
-		input,
-		[]byte(internal.InputsHeader),
-		[]byte(internal.InputAutoDocEnd),
-	)
+	a.Outputs = make(map[string]ActionOutput, len(workflow.On.WorkflowCall.Outputs))
+	for name, output := range workflow.On.WorkflowCall.Outputs {
+		a.Outputs[name] = ActionOutput{
+			Description: output.Description,
+			Value:       output.Value,
+		}
+	}
+
+	a.Secrets = make(map[string]ActionSecret, len(workflow.On.WorkflowCall.Secrets))
+	for name, secret := range workflow.On.WorkflowCall.Secrets {
+		a.Secrets[name] = ActionSecret{
+			Description: secret.Description,
+			Required:    secret.Required,
+		}
+	}
+
+	return nil
+}
+
+// spliceDocumentation returns the result of splicing inputTable/outputTable/
+// secretTable into input between the auto-doc markers, without writing
+// anything. A table that rendered no content (e.g. an action.yml with no
+// outputs) leaves its section untouched instead of blanking out the header
+// and AUTO-DOC markers. Both WriteDocumentation and DiffDocumentation build
+// on it.
+func spliceDocumentation(input []byte, inputTable, outputTable, secretTable *strings.Builder) []byte {
+	output := input
+
+	if inputTable.Len() > 0 {
+		hasInputsData, inputStartIndex, inputEndIndex := utils.HasBytesInBetween(
+			output,
+			[]byte(internal.InputsHeader),
+			[]byte(internal.InputAutoDocEnd),
+		)
 
-	if hasInputsData {
-		inputsStr := fmt.Sprintf("%s\n\n%v", internal.InputsHeader, inputTable.String())
-		output = utils.ReplaceBytesInBetween(input, inputStartIndex, inputEndIndex, []byte(inputsStr))
-	} else {
 		inputsStr := fmt.Sprintf("%s\n\n%v", internal.InputsHeader, inputTable.String())
-		output = bytes.Replace(input, []byte(internal.InputsHeader), []byte(inputsStr), -1)
+		if hasInputsData {
+			output = utils.ReplaceBytesInBetween(output, inputStartIndex, inputEndIndex, []byte(inputsStr))
+		} else {
+			output = bytes.Replace(output, []byte(internal.InputsHeader), []byte(inputsStr), -1)
+		}
 	}
 
-	hasOutputsData, outputStartIndex, outputEndIndex := utils.HasBytesInBetween(
-		output,
-		[]byte(internal.OutputsHeader),
-		[]byte(internal.OutputAutoDocEnd),
-	)
+	if outputTable.Len() > 0 {
+		hasOutputsData, outputStartIndex, outputEndIndex := utils.HasBytesInBetween(
+			output,
+			[]byte(internal.OutputsHeader),
+			[]byte(internal.OutputAutoDocEnd),
+		)
 
-	if hasOutputsData {
-		outputsStr := fmt.Sprintf("%s\n\n%v", internal.OutputsHeader, outputTable.String())
-		output = utils.ReplaceBytesInBetween(output, outputStartIndex, outputEndIndex, []byte(outputsStr))
-	} else {
 		outputsStr := fmt.Sprintf("%s\n\n%v", internal.OutputsHeader, outputTable.String())
-		output = bytes.Replace(output, []byte(internal.OutputsHeader), []byte(outputsStr), -1)
+		if hasOutputsData {
+			output = utils.ReplaceBytesInBetween(output, outputStartIndex, outputEndIndex, []byte(outputsStr))
+		} else {
+			output = bytes.Replace(output, []byte(internal.OutputsHeader), []byte(outputsStr), -1)
+		}
 	}
 
+	if secretTable.Len() > 0 {
+		hasSecretsData, secretStartIndex, secretEndIndex := utils.HasBytesInBetween(
+			output,
+			[]byte(internal.SecretsHeader),
+			[]byte(internal.SecretAutoDocEnd),
+		)
+
+		secretsStr := fmt.Sprintf("%s\n\n%v", internal.SecretsHeader, secretTable.String())
+		if hasSecretsData {
+			output = utils.ReplaceBytesInBetween(output, secretStartIndex, secretEndIndex, []byte(secretsStr))
+		} else {
+			output = bytes.Replace(output, []byte(internal.SecretsHeader), []byte(secretsStr), -1)
+		}
+	}
+
+	return output
+}
+
+func (a *Action) WriteDocumentation(inputTable, outputTable, secretTable *strings.Builder) error {
+	input, err := os.ReadFile(a.OutputFileName)
+	if err != nil {
+		return err
+	}
+
+	output := spliceDocumentation(input, inputTable, outputTable, secretTable)
+
 	if len(output) > 0 {
 		if err = os.WriteFile(a.OutputFileName, output, 0666); err != nil {
 			cobra.CheckErr(err)
@@ -95,188 +232,609 @@ func (a *Action) WriteDocumentation(inputTable, outputTable *strings.Builder) er
 	return nil
 }
 
+// DiffDocumentation splices inputTable/outputTable/secretTable the same way
+// WriteDocumentation does, but instead of writing OutputFileName it returns
+// a unified diff against its existing content (empty if nothing changed).
+func (a *Action) DiffDocumentation(inputTable, outputTable, secretTable *strings.Builder) (string, error) {
+	input, err := os.ReadFile(a.OutputFileName)
+	if err != nil {
+		return "", err
+	}
+
+	output := spliceDocumentation(input, inputTable, outputTable, secretTable)
+
+	return utils.UnifiedDiff(a.OutputFileName, a.OutputFileName, string(input), string(output)), nil
+}
+
 func (a *Action) RenderOutput() error {
-	var err error
-	maxWidth, err := strconv.Atoi(a.ColMaxWidth)
+	format := render.Format(a.Format)
+
+	tables, err := a.prepareTables()
 	if err != nil {
 		return err
 	}
 
+	if !render.IsSpliced(format) {
+		if err = a.writeRenderedFile(format, tables); err != nil {
+			return err
+		}
+	} else {
+		inputTableOutput, err := renderActionInputTableOutput(tables.renderer, tables.inputCols, tables.inputRows)
+		if err != nil {
+			return err
+		}
+
+		outputTableOutput, err := renderActionOutputTableOutput(tables.renderer, tables.outputCols, tables.outputRows)
+		if err != nil {
+			return err
+		}
+
+		secretTableOutput, err := renderActionSecretTableOutput(tables.renderer, tables.secretCols, tables.secretRows)
+		if err != nil {
+			return err
+		}
+
+		if err = a.WriteDocumentation(inputTableOutput, outputTableOutput, secretTableOutput); err != nil {
+			return err
+		}
+	}
+
+	if a.EmitSchema {
+		return a.writeJSONSchema()
+	}
+
+	return nil
+}
+
+// Check renders the input/output/secret tables the same way RenderOutput
+// does, but returns a unified diff instead of writing anything, so callers
+// (e.g. a --check flag) can detect stale docs. Like RenderOutput, it diffs
+// against OutputFileName when Format is spliced (markdown), or against
+// format's sibling file otherwise. If EmitSchema is set, it also diffs the
+// JSON Schema document the same way writeJSONSchema would write it, so
+// "--check --emit-schema" catches a stale schema, not just stale tables.
+func (a *Action) Check() (string, error) {
+	format := render.Format(a.Format)
+
+	tables, err := a.prepareTables()
+	if err != nil {
+		return "", err
+	}
+
+	var diff string
+	if !render.IsSpliced(format) {
+		diff, err = a.diffRenderedFile(format, tables)
+	} else {
+		diff, err = a.diffSplicedDocumentation(tables)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if a.EmitSchema {
+		schemaDiff, err := a.diffJSONSchema()
+		if err != nil {
+			return "", err
+		}
+		diff += schemaDiff
+	}
+
+	return diff, nil
+}
+
+// diffSplicedDocumentation renders the input/output/secret tables and
+// returns DiffDocumentation's unified diff against OutputFileName, shared by
+// Check's markdown (spliced) path.
+func (a *Action) diffSplicedDocumentation(tables *preparedTables) (string, error) {
+	inputTableOutput, err := renderActionInputTableOutput(tables.renderer, tables.inputCols, tables.inputRows)
+	if err != nil {
+		return "", err
+	}
+
+	outputTableOutput, err := renderActionOutputTableOutput(tables.renderer, tables.outputCols, tables.outputRows)
+	if err != nil {
+		return "", err
+	}
+
+	secretTableOutput, err := renderActionSecretTableOutput(tables.renderer, tables.secretCols, tables.secretRows)
+	if err != nil {
+		return "", err
+	}
+
+	return a.DiffDocumentation(inputTableOutput, outputTableOutput, secretTableOutput)
+}
+
+// diffRenderedFile renders the input/output/secret tables the same way
+// writeRenderedFile does, but returns a unified diff against format's
+// existing sibling file instead of writing it.
+func (a *Action) diffRenderedFile(format render.Format, tables *preparedTables) (string, error) {
+	doc, err := a.renderDocument(format, tables)
+	if err != nil {
+		return "", err
+	}
+
+	siblingFileName := render.SiblingFileName(a.OutputFileName, format)
+
+	existing, err := os.ReadFile(siblingFileName)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.UnifiedDiff(siblingFileName, siblingFileName, string(existing), doc), nil
+}
+
+// preparedTables bundles the resolved Renderer with the cols/rows pairs for
+// the inputs, outputs, and secrets tables, shared by RenderOutput and Check.
+type preparedTables struct {
+	renderer render.Renderer
+
+	inputCols []string
+	inputRows [][]string
+
+	outputCols []string
+	outputRows [][]string
+
+	secretCols []string
+	secretRows [][]string
+}
+
+// prepareTables resolves the configured Renderer and builds the cols/rows
+// pairs for the inputs, outputs, and secrets tables, shared by RenderOutput
+// and Check.
+func (a *Action) prepareTables() (*preparedTables, error) {
+	maxWidth, err := strconv.Atoi(a.ColMaxWidth)
+	if err != nil {
+		return nil, err
+	}
+
 	maxWords, err := strconv.Atoi(a.ColMaxWords)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	inputTableOutput, err := renderActionInputTableOutput(a.Inputs, a.InputColumns, maxWidth, maxWords)
+	renderer, err := render.New(render.Format(a.Format), maxWidth)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	outputTableOutput, err := renderActionOutputTableOutput(a.Outputs, a.OutputColumns, maxWidth, maxWords)
+	inputCols, inputRows, err := buildActionInputRows(a.Inputs, a.InputColumns, maxWords)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	err = a.WriteDocumentation(inputTableOutput, outputTableOutput)
+	outputCols, outputRows, err := buildActionOutputRows(a.Outputs, a.OutputColumns, maxWords)
+	if err != nil {
+		return nil, err
+	}
+
+	secretCols, secretRows, err := buildActionSecretRows(a.Secrets, a.SecretColumns, maxWords)
+	if err != nil {
+		return nil, err
+	}
+
+	return &preparedTables{
+		renderer:   renderer,
+		inputCols:  inputCols,
+		inputRows:  inputRows,
+		outputCols: outputCols,
+		outputRows: outputRows,
+		secretCols: secretCols,
+		secretRows: secretRows,
+	}, nil
+}
+
+// writeRenderedFile renders the input/output/secret tables with
+// tables.renderer and writes the result to format's sibling file (e.g.
+// README.md.json) instead of splicing it into OutputFileName.
+func (a *Action) writeRenderedFile(format render.Format, tables *preparedTables) error {
+	doc, err := a.renderDocument(format, tables)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	siblingFileName := render.SiblingFileName(a.OutputFileName, format)
+
+	return os.WriteFile(siblingFileName, []byte(doc), 0666)
 }
 
-func renderActionInputTableOutput(i map[string]ActionInput, inputColumns[]string, maxWidth int, maxWords int) (*strings.Builder, error) {
-	inputTableOutput := &strings.Builder{}
+// renderDocument renders the input/output/secret tables with tables.renderer
+// and assembles them into the sibling-file document for format, shared by
+// writeRenderedFile and diffRenderedFile.
+func (a *Action) renderDocument(format render.Format, tables *preparedTables) (string, error) {
+	inputBody, err := tables.renderer.Render(tables.inputCols, tables.inputRows)
+	if err != nil {
+		return "", err
+	}
+
+	outputBody, err := tables.renderer.Render(tables.outputCols, tables.outputRows)
+	if err != nil {
+		return "", err
+	}
 
-	if len(i) > 0 {
-		_, err := fmt.Fprintln(inputTableOutput, internal.InputAutoDocStart)
+	secretBody, err := tables.renderer.Render(tables.secretCols, tables.secretRows)
+	if err != nil {
+		return "", err
+	}
+
+	return assembleRenderedDocument(format, inputBody, outputBody, secretBody)
+}
+
+func assembleRenderedDocument(format render.Format, inputBody, outputBody, secretBody string) (string, error) {
+	switch format {
+	case render.FormatJSON:
+		doc := struct {
+			Inputs  json.RawMessage `json:"inputs"`
+			Outputs json.RawMessage `json:"outputs"`
+			Secrets json.RawMessage `json:"secrets"`
+		}{
+			Inputs:  json.RawMessage(inputBody),
+			Outputs: json.RawMessage(outputBody),
+			Secrets: json.RawMessage(secretBody),
+		}
+
+		out, err := json.MarshalIndent(doc, "", "  ")
 		if err != nil {
-			return inputTableOutput, err
+			return "", err
 		}
 
-		inputTable := tablewriter.NewWriter(inputTableOutput)
-		inputTable.SetHeader(inputColumns)
-		inputTable.SetBorders(tablewriter.Border{Left: true, Top: false, Right: true, Bottom: false})
-		inputTable.SetCenterSeparator(internal.PipeSeparator)
-		inputTable.SetAlignment(tablewriter.ALIGN_CENTER)
+		return string(out), nil
+	case render.FormatHTML:
+		return fmt.Sprintf("<h2>%s</h2>\n%s\n\n<h2>%s</h2>\n%s\n\n<h2>%s</h2>\n%s",
+			strings.TrimPrefix(internal.InputsHeader, "## "), inputBody,
+			strings.TrimPrefix(internal.OutputsHeader, "## "), outputBody,
+			strings.TrimPrefix(internal.SecretsHeader, "## "), secretBody), nil
+	case render.FormatAsciiDoc:
+		return fmt.Sprintf("== %s\n\n%s\n\n== %s\n\n%s\n\n== %s\n\n%s",
+			strings.TrimPrefix(internal.InputsHeader, "## "), inputBody,
+			strings.TrimPrefix(internal.OutputsHeader, "## "), outputBody,
+			strings.TrimPrefix(internal.SecretsHeader, "## "), secretBody), nil
+	default:
+		return fmt.Sprintf("%s\n\n%s\n\n%s\n\n%s\n\n%s\n\n%s",
+			internal.InputsHeader, inputBody,
+			internal.OutputsHeader, outputBody,
+			internal.SecretsHeader, secretBody), nil
+	}
+}
 
-		keys := make([]string, 0, len(i))
-		for k := range i {
-			keys = append(keys, k)
-		}
-		sort.Strings(keys)
-
-		inputTable.SetColWidth(maxWidth)
-
-		for _, key := range keys {
-			var inputDefault string
-			if len(i[key].Default) > 0 {
-				inputDefault = i[key].Default
-				var defaultValue string
-				var parts = strings.Split(inputDefault, "\n")
-
-				if len(parts) > 1 && inputDefault != internal.NewLineSeparator {
-					for _, part := range parts {
-						if part != "" {
-							defaultValue += "`\"" + part + "\"`" + "<br>"
-						}
-					}
-				} else {
-					if strings.Contains(inputDefault, internal.PipeSeparator) {
-						inputDefault = strings.Replace(inputDefault, internal.PipeSeparator, "\"\\"+internal.PipeSeparator+"\"", -1)
-					} else {
-						inputDefault = fmt.Sprintf("%#v", i[key].Default)
-					}
-					defaultValue = "`" + inputDefault + "`"
-				}
-
-				inputDefault = defaultValue
+// buildActionInputRows sorts a.Inputs by key and builds the cols/rows pair
+// consumed by a render.Renderer.
+func buildActionInputRows(i map[string]ActionInput, inputColumns []string, maxWords int) ([]string, [][]string, error) {
+	keys := make([]string, 0, len(i))
+	for k := range i {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	rows := make([][]string, 0, len(keys))
+
+	for _, key := range keys {
+		var row []string
+
+		for _, col := range inputColumns {
+			switch col {
+			case "Input":
+				row = append(row, key)
+			case "Type":
+				row = append(row, inferInputType(i[key]))
+			case "Required":
+				row = append(row, strconv.FormatBool(i[key].Required))
+			case "Default":
+				row = append(row, i[key].Default)
+			case "Description":
+				row = append(row, utils.WordWrap(i[key].Description, maxWords))
+			case "Options":
+				row = append(row, strings.Join(i[key].Options, ", "))
+			case "Deprecation":
+				row = append(row, i[key].DeprecationMessage)
+			default:
+				return inputColumns, nil, fmt.Errorf(
+					"unknown input column: '%s'. Please specify any of the following columns: %s",
+					col,
+					strings.Join(append(append([]string{}, internal.DefaultActionInputColumns...), "Options", "Deprecation"), ", "),
+				)
 			}
+		}
+		rows = append(rows, row)
+	}
+
+	return inputColumns, rows, nil
+}
 
-			var row []string
-
-			for _, col := range inputColumns {
-				switch col {
-				case "Input":
-					row = append(row, key)
-				case "Type":
-					row = append(row, "string")
-				case "Required":
-					row = append(row, strconv.FormatBool(i[key].Required))
-				case "Default":
-					row = append(row, inputDefault)
-				case "Description":
-					row = append(row, utils.WordWrap(i[key].Description, maxWords))
-				default:
-					return inputTableOutput, fmt.Errorf(
-						"unknown input column: '%s'. Please specify any of the following columns: %s",
-						col,
-						strings.Join(internal.DefaultActionInputColumns, ", "),
-					)
-				}
+// buildActionOutputRows sorts a.Outputs by key and builds the cols/rows pair
+// consumed by a render.Renderer.
+func buildActionOutputRows(o map[string]ActionOutput, outputColumns []string, maxWords int) ([]string, [][]string, error) {
+	keys := make([]string, 0, len(o))
+	for k := range o {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	rows := make([][]string, 0, len(keys))
+
+	for _, key := range keys {
+		var row []string
+
+		for _, col := range outputColumns {
+			switch col {
+			case "Output":
+				row = append(row, key)
+			case "Type":
+				row = append(row, "string")
+			case "Description":
+				row = append(row, utils.WordWrap(o[key].Description, maxWords))
+			default:
+				return outputColumns, nil, fmt.Errorf(
+					"unknown output column: '%s'. Please specify any of the following columns: %s",
+					col,
+					strings.Join(internal.DefaultActionOutputColumns, ", "),
+				)
 			}
-			inputTable.Append(row)
 		}
+		rows = append(rows, row)
+	}
 
-		_, err = fmt.Fprintln(inputTableOutput)
-		if err != nil {
-			return inputTableOutput, err
+	return outputColumns, rows, nil
+}
+
+// buildActionSecretRows sorts a.Secrets by key and builds the cols/rows pair
+// consumed by a render.Renderer.
+func buildActionSecretRows(s map[string]ActionSecret, secretColumns []string, maxWords int) ([]string, [][]string, error) {
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	rows := make([][]string, 0, len(keys))
+
+	for _, key := range keys {
+		var row []string
+
+		for _, col := range secretColumns {
+			switch col {
+			case "Secret":
+				row = append(row, key)
+			case "Required":
+				row = append(row, strconv.FormatBool(s[key].Required))
+			case "Description":
+				row = append(row, utils.WordWrap(s[key].Description, maxWords))
+			default:
+				return secretColumns, nil, fmt.Errorf(
+					"unknown secret column: '%s'. Please specify any of the following columns: %s",
+					col,
+					strings.Join(internal.DefaultSecretColumns, ", "),
+				)
+			}
 		}
+		rows = append(rows, row)
+	}
 
-		inputTable.Render()
+	return secretColumns, rows, nil
+}
 
-		_, err = fmt.Fprintln(inputTableOutput)
-		if err != nil {
-			return inputTableOutput, err
-		}
+func renderActionInputTableOutput(renderer render.Renderer, inputColumns []string, rows [][]string) (*strings.Builder, error) {
+	inputTableOutput := &strings.Builder{}
 
-		_, err = fmt.Fprint(inputTableOutput, internal.InputAutoDocEnd)
+	if len(rows) > 0 {
+		body, err := renderer.Render(inputColumns, rows)
 		if err != nil {
 			return inputTableOutput, err
 		}
+
+		fmt.Fprintln(inputTableOutput, internal.InputAutoDocStart)
+		fmt.Fprintln(inputTableOutput)
+		fmt.Fprint(inputTableOutput, body)
+		fmt.Fprintln(inputTableOutput)
+		fmt.Fprint(inputTableOutput, internal.InputAutoDocEnd)
 	}
+
 	return inputTableOutput, nil
 }
 
-func renderActionOutputTableOutput(o map[string]ActionOutput, outputColumns[]string, maxWidth int, maxWords int) (*strings.Builder, error) {
+func renderActionOutputTableOutput(renderer render.Renderer, outputColumns []string, rows [][]string) (*strings.Builder, error) {
 	outputTableOutput := &strings.Builder{}
 
-	if len(o) > 0 {
-		_, err := fmt.Fprintln(outputTableOutput, internal.OutputAutoDocStart)
+	if len(rows) > 0 {
+		body, err := renderer.Render(outputColumns, rows)
 		if err != nil {
 			return outputTableOutput, err
 		}
 
-		outputTable := tablewriter.NewWriter(outputTableOutput)
-		outputTable.SetHeader(outputColumns)
-		outputTable.SetBorders(tablewriter.Border{Left: true, Top: false, Right: true, Bottom: false})
-		outputTable.SetCenterSeparator(internal.PipeSeparator)
-		outputTable.SetAlignment(tablewriter.ALIGN_CENTER)
+		fmt.Fprintln(outputTableOutput, internal.OutputAutoDocStart)
+		fmt.Fprintln(outputTableOutput)
+		fmt.Fprint(outputTableOutput, body)
+		fmt.Fprintln(outputTableOutput)
+		fmt.Fprint(outputTableOutput, internal.OutputAutoDocEnd)
+	}
 
-		keys := make([]string, 0, len(o))
-		for k := range o {
-			keys = append(keys, k)
-		}
-		sort.Strings(keys)
-
-		outputTable.SetColWidth(maxWidth)
-		for _, key := range keys {
-			var row []string
-
-			for _, col := range outputColumns {
-				switch col {
-				case "Output":
-					row = append(row, key)
-				case "Type":
-					row = append(row, "string")
-				case "Description":
-					row = append(row, utils.WordWrap(o[key].Description, maxWords))
-				default:
-					return outputTableOutput, fmt.Errorf(
-						"unknown output column: '%s'. Please specify any of the following columns: %s",
-						col,
-						strings.Join(internal.DefaultActionOutputColumns, ", "),
-					)
-				}
-			}
-			outputTable.Append(row)
-		}
+	return outputTableOutput, nil
+}
+
+func renderActionSecretTableOutput(renderer render.Renderer, secretColumns []string, rows [][]string) (*strings.Builder, error) {
+	secretTableOutput := &strings.Builder{}
 
-		_, err = fmt.Fprintln(outputTableOutput)
+	if len(rows) > 0 {
+		body, err := renderer.Render(secretColumns, rows)
 		if err != nil {
-			return outputTableOutput, err
+			return secretTableOutput, err
 		}
-		outputTable.Render()
 
-		_, err = fmt.Fprintln(outputTableOutput)
-		if err != nil {
-			return outputTableOutput, err
+		fmt.Fprintln(secretTableOutput, internal.SecretAutoDocStart)
+		fmt.Fprintln(secretTableOutput)
+		fmt.Fprint(secretTableOutput, body)
+		fmt.Fprintln(secretTableOutput)
+		fmt.Fprint(secretTableOutput, internal.SecretAutoDocEnd)
+	}
+
+	return secretTableOutput, nil
+}
+
+// jsonSchemaProperty is a single entry of a Draft 2020-12 JSON Schema
+// "properties" object, describing one action input. Default is typed as
+// any rather than string so it marshals as a JSON bool/number/string
+// matching Type, not a string regardless of Type.
+type jsonSchemaProperty struct {
+	Type        string   `json:"type"`
+	Description string   `json:"description,omitempty"`
+	Default     any      `json:"default,omitempty"`
+	Enum        []string `json:"enum,omitempty"`
+}
+
+// jsonSchemaDocument is the top-level Draft 2020-12 JSON Schema document
+// produced by Action.JSONSchema.
+type jsonSchemaDocument struct {
+	Schema     string                        `json:"$schema"`
+	Type       string                        `json:"type"`
+	Properties map[string]jsonSchemaProperty `json:"properties"`
+	Required   []string                      `json:"required,omitempty"`
+}
+
+// JSONSchema generates a Draft 2020-12 JSON Schema document describing
+// a.Inputs, keyed by input name, so downstream tooling (IDE completion,
+// workflow validators) can consume a machine-readable contract.
+func (a *Action) JSONSchema() ([]byte, error) {
+	schema := jsonSchemaDocument{
+		Schema:     "https://json-schema.org/draft/2020-12/schema",
+		Type:       "object",
+		Properties: make(map[string]jsonSchemaProperty, len(a.Inputs)),
+	}
+
+	keys := make([]string, 0, len(a.Inputs))
+	for k := range a.Inputs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		input := a.Inputs[key]
+
+		property := jsonSchemaProperty{
+			Type:        jsonSchemaType(inferInputType(input)),
+			Description: input.Description,
+			Default:     jsonSchemaDefault(input),
+			Enum:        input.Options,
 		}
 
-		_, err = fmt.Fprint(outputTableOutput, internal.OutputAutoDocEnd)
-		if err != nil {
-			return outputTableOutput, err
+		schema.Properties[key] = property
+
+		if input.Required {
+			schema.Required = append(schema.Required, key)
 		}
 	}
-	return outputTableOutput, nil
-}
\ No newline at end of file
+
+	return json.MarshalIndent(schema, "", "  ")
+}
+
+// jsonSchemaType maps the richer Type column value inferred by
+// inferInputType onto a JSON Schema primitive type.
+func jsonSchemaType(inferred string) string {
+	switch {
+	case inferred == "boolean":
+		return "boolean"
+	case inferred == "number":
+		return "number"
+	default:
+		return "string"
+	}
+}
+
+// jsonSchemaDefault converts input.Default to the Go type matching its
+// inferred schema type (bool, float64, or string), so the emitted "default"
+// value's JSON type always matches the property's declared "type". Returns
+// nil (omitted via the struct's omitempty) when there is no default.
+func jsonSchemaDefault(input ActionInput) any {
+	if input.Default == "" {
+		return nil
+	}
+
+	switch jsonSchemaType(inferInputType(input)) {
+	case "boolean":
+		if b, err := strconv.ParseBool(input.Default); err == nil {
+			return b
+		}
+	case "number":
+		if f, err := strconv.ParseFloat(input.Default, 64); err == nil {
+			return f
+		}
+	}
+
+	return input.Default
+}
+
+// jsonSchemaFileName returns the sibling file writeJSONSchema/diffJSONSchema
+// write/diff the JSON Schema document against.
+func (a *Action) jsonSchemaFileName() string {
+	return strings.TrimSuffix(a.OutputFileName, filepath.Ext(a.OutputFileName)) + ".schema.json"
+}
+
+// spliceJSONSchema returns the result of splicing schema into input as a
+// fenced json block between the AUTO-DOC-SCHEMA markers, or input unchanged
+// if the markers aren't present.
+func spliceJSONSchema(input, schema []byte) []byte {
+	hasSchemaMarkers, startIndex, endIndex := utils.HasBytesInBetween(
+		input,
+		[]byte(internal.SchemaAutoDocStart),
+		[]byte(internal.SchemaAutoDocEnd),
+	)
+	if !hasSchemaMarkers {
+		return input
+	}
+
+	block := fmt.Sprintf("%s\n\n```json\n%s\n```\n\n%s", internal.SchemaAutoDocStart, schema, internal.SchemaAutoDocEnd)
+	return utils.ReplaceBytesInBetween(input, startIndex, endIndex, []byte(block))
+}
+
+// writeJSONSchema writes a.JSONSchema() to the sibling file named by
+// jsonSchemaFileName (README.md -> README.schema.json) and, if
+// OutputFileName already contains AUTO-DOC-SCHEMA markers,
+// splices it in as a fenced json block.
+func (a *Action) writeJSONSchema() error {
+	schema, err := a.JSONSchema()
+	if err != nil {
+		return err
+	}
+
+	if err = os.WriteFile(a.jsonSchemaFileName(), schema, 0666); err != nil {
+		return err
+	}
+
+	input, err := os.ReadFile(a.OutputFileName)
+	if err != nil {
+		return err
+	}
+
+	output := spliceJSONSchema(input, schema)
+	if bytes.Equal(output, input) {
+		return nil
+	}
+
+	return os.WriteFile(a.OutputFileName, output, 0666)
+}
+
+// diffJSONSchema renders a.JSONSchema() the same way writeJSONSchema does,
+// but returns a unified diff instead of writing anything: one hunk against
+// the existing .schema.json sibling file, and (if OutputFileName has
+// AUTO-DOC-SCHEMA markers) one against the spliced block within it. Used by
+// Check so "--check --emit-schema" catches a stale schema.
+func (a *Action) diffJSONSchema() (string, error) {
+	schema, err := a.JSONSchema()
+	if err != nil {
+		return "", err
+	}
+
+	schemaFileName := a.jsonSchemaFileName()
+	existingSchema, err := os.ReadFile(schemaFileName)
+	if err != nil {
+		return "", err
+	}
+	diff := utils.UnifiedDiff(schemaFileName, schemaFileName, string(existingSchema), string(schema))
+
+	input, err := os.ReadFile(a.OutputFileName)
+	if err != nil {
+		return "", err
+	}
+	output := spliceJSONSchema(input, schema)
+	diff += utils.UnifiedDiff(a.OutputFileName, a.OutputFileName, string(input), string(output))
+
+	return diff, nil
+}
@@ -0,0 +1,252 @@
+package types
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestActionGetDataReusableWorkflow(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "workflow.yml")
+	content := `on:
+  workflow_call:
+    inputs:
+      foo:
+        description: "a foo input"
+        required: true
+    outputs:
+      bar:
+        description: "a bar output"
+        value: "baz"
+    secrets:
+      token:
+        description: "a token secret"
+        required: true
+`
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	action := &Action{InputFileName: path}
+	if err := action.GetData(); err != nil {
+		t.Fatalf("GetData() error = %v", err)
+	}
+
+	if _, ok := action.Inputs["foo"]; !ok {
+		t.Fatalf("expected Inputs to contain %q, got %v", "foo", action.Inputs)
+	}
+	if !action.Inputs["foo"].Required {
+		t.Errorf("expected foo.Required = true")
+	}
+	if _, ok := action.Outputs["bar"]; !ok {
+		t.Fatalf("expected Outputs to contain %q, got %v", "bar", action.Outputs)
+	}
+	if _, ok := action.Secrets["token"]; !ok {
+		t.Fatalf("expected Secrets to contain %q, got %v", "token", action.Secrets)
+	}
+	if !action.Secrets["token"].Required {
+		t.Errorf("expected token.Required = true")
+	}
+}
+
+func TestInferInputType(t *testing.T) {
+	tests := []struct {
+		name  string
+		input ActionInput
+		want  string
+	}{
+		{"boolean true", ActionInput{Default: "true"}, "boolean"},
+		{"boolean false", ActionInput{Default: "false"}, "boolean"},
+		{"number", ActionInput{Default: "42"}, "number"},
+		{"choice", ActionInput{Default: "a", Options: []string{"a", "b", "c"}}, "choice<a|b|c>"},
+		{"multiline", ActionInput{Default: "line1\nline2"}, "multiline"},
+		{"plain string", ActionInput{Default: "foo"}, "string"},
+		{"no default", ActionInput{}, "string"},
+		{"options take priority over default", ActionInput{Default: "true", Options: []string{"x", "y"}}, "choice<x|y>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := inferInputType(tt.input); got != tt.want {
+				t.Errorf("inferInputType(%+v) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONSchemaDefaultMatchesDeclaredType(t *testing.T) {
+	action := &Action{
+		Inputs: map[string]ActionInput{
+			"enabled": {Default: "true"},
+			"count":   {Default: "3"},
+			"name":    {Default: "foo"},
+		},
+	}
+
+	out, err := action.JSONSchema()
+	if err != nil {
+		t.Fatalf("JSONSchema() error = %v", err)
+	}
+
+	var doc struct {
+		Properties map[string]struct {
+			Type    string `json:"type"`
+			Default any    `json:"default"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(out, &doc); err != nil {
+		t.Fatalf("json.Unmarshal(JSONSchema()) error = %v", err)
+	}
+
+	if _, ok := doc.Properties["enabled"].Default.(bool); !ok {
+		t.Errorf("enabled.default = %#v (%T), want a JSON bool", doc.Properties["enabled"].Default, doc.Properties["enabled"].Default)
+	}
+	if _, ok := doc.Properties["count"].Default.(float64); !ok {
+		t.Errorf("count.default = %#v (%T), want a JSON number", doc.Properties["count"].Default, doc.Properties["count"].Default)
+	}
+	if _, ok := doc.Properties["name"].Default.(string); !ok {
+		t.Errorf("name.default = %#v (%T), want a JSON string", doc.Properties["name"].Default, doc.Properties["name"].Default)
+	}
+}
+
+func TestCheckEmitSchemaDetectsStaleSchema(t *testing.T) {
+	dir := t.TempDir()
+	inputPath := filepath.Join(dir, "action.yml")
+	if err := os.WriteFile(inputPath, []byte("inputs:\n  foo:\n    description: a foo input\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	outputPath := filepath.Join(dir, "README.md")
+	if err := os.WriteFile(outputPath, []byte("## Inputs\n\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	action := &Action{
+		InputFileName:  inputPath,
+		OutputFileName: outputPath,
+		ColMaxWidth:    "40",
+		ColMaxWords:    "80",
+		InputColumns:   []string{"Input"},
+		OutputColumns:  []string{"Output"},
+		SecretColumns:  []string{"Secret"},
+		EmitSchema:     true,
+	}
+	if err := action.GetData(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := action.RenderOutput(); err != nil {
+		t.Fatalf("RenderOutput() error = %v", err)
+	}
+
+	if diff, err := action.Check(); err != nil || diff != "" {
+		t.Fatalf("Check() right after RenderOutput() = (%q, %v), want an empty diff", diff, err)
+	}
+
+	// Hand-edit the schema sibling file out from under auto-doc, leaving
+	// README.md (and thus the input/output/secret tables) untouched. Only a
+	// Check() that also consults EmitSchema can catch this.
+	schemaPath := filepath.Join(dir, "README.schema.json")
+	if err := os.WriteFile(schemaPath, []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := action.Check()
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if diff == "" {
+		t.Error("Check() with a hand-edited README.schema.json: expected a non-empty diff, got none")
+	}
+}
+
+func TestBuildActionInputRowsUnknownColumn(t *testing.T) {
+	_, _, err := buildActionInputRows(map[string]ActionInput{"foo": {}}, []string{"Bogus"}, 80)
+	if err == nil {
+		t.Fatal("buildActionInputRows() with an unknown column: expected an error, got nil")
+	}
+}
+
+func TestBuildActionOutputRowsUnknownColumn(t *testing.T) {
+	_, _, err := buildActionOutputRows(map[string]ActionOutput{"foo": {}}, []string{"Bogus"}, 80)
+	if err == nil {
+		t.Fatal("buildActionOutputRows() with an unknown column: expected an error, got nil")
+	}
+}
+
+func TestBuildActionSecretRowsUnknownColumn(t *testing.T) {
+	_, _, err := buildActionSecretRows(map[string]ActionSecret{"foo": {}}, []string{"Bogus"}, 80)
+	if err == nil {
+		t.Fatal("buildActionSecretRows() with an unknown column: expected an error, got nil")
+	}
+}
+
+func TestCheckJSONFormatDiffsSiblingFile(t *testing.T) {
+	dir := t.TempDir()
+	inputPath := filepath.Join(dir, "action.yml")
+	if err := os.WriteFile(inputPath, []byte("inputs:\n  foo:\n    description: a foo input\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	outputPath := filepath.Join(dir, "README.md")
+	if err := os.WriteFile(outputPath, []byte("## Inputs\n\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	action := &Action{
+		InputFileName:  inputPath,
+		OutputFileName: outputPath,
+		ColMaxWidth:    "40",
+		ColMaxWords:    "80",
+		InputColumns:   []string{"Input"},
+		OutputColumns:  []string{"Output"},
+		SecretColumns:  []string{"Secret"},
+		Format:         "json",
+	}
+	if err := action.GetData(); err != nil {
+		t.Fatal(err)
+	}
+
+	// README.md.json doesn't exist yet, so the diff should be against the
+	// sibling file (not README.md) and report it as missing/out of date.
+	if _, err := action.Check(); err == nil {
+		t.Fatal("Check() with no existing README.md.json: expected an error, got nil")
+	}
+
+	if err := action.RenderOutput(); err != nil {
+		t.Fatalf("RenderOutput() error = %v", err)
+	}
+
+	diff, err := action.Check()
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if diff != "" {
+		t.Errorf("Check() after RenderOutput() = %q, want empty diff", diff)
+	}
+
+	readmeContent, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(readmeContent) != "## Inputs\n\n" {
+		t.Errorf("Check() with --format json must not touch README.md, got: %s", readmeContent)
+	}
+}
+
+func TestSpliceDocumentationPreservesMarkersWhenTableEmpty(t *testing.T) {
+	input := []byte("## Inputs\n\n<!-- AUTO-DOC-INPUT:START -->\nold\n<!-- AUTO-DOC-INPUT:END -->\n\n" +
+		"## Outputs\n\n<!-- AUTO-DOC-OUTPUT:START -->\nold\n<!-- AUTO-DOC-OUTPUT:END -->\n")
+
+	output := spliceDocumentation(input, &strings.Builder{}, &strings.Builder{}, &strings.Builder{})
+
+	if !strings.Contains(string(output), "AUTO-DOC-INPUT:START") || !strings.Contains(string(output), "AUTO-DOC-INPUT:END") {
+		t.Fatalf("expected input markers to survive an empty table, got: %s", output)
+	}
+	if !strings.Contains(string(output), "AUTO-DOC-OUTPUT:START") || !strings.Contains(string(output), "AUTO-DOC-OUTPUT:END") {
+		t.Fatalf("expected output markers to survive an empty table, got: %s", output)
+	}
+}
@@ -0,0 +1,153 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+)
+
+// DiscoverOptions scopes which files Discover considers within root.
+type DiscoverOptions struct {
+	// Include, when non-empty, restricts matches to paths (relative to
+	// root) matching at least one of these glob patterns. "**" matches zero
+	// or more path segments (e.g. "vendor/**"); see matchGlob.
+	Include []string
+	// Exclude drops any path matching one of these glob patterns, even if
+	// it matched Include. "**" matches zero or more path segments (e.g.
+	// "vendor/**"); see matchGlob.
+	Exclude []string
+}
+
+// Discover walks root looking for action.yml/action.yaml files and reusable
+// workflow files (on.workflow_call) under .github/workflows, returning one
+// *Action per match with its Inputs/Outputs already populated. This powers
+// the "auto-doc all" command for documenting a monorepo in one invocation.
+// A file that fails to parse is recorded in the returned error (joined via
+// errors.Join) rather than aborting the walk, so one malformed action.yml
+// doesn't keep every other discovered action from being documented. A
+// ".github/workflows" file that parses fine but has no on.workflow_call
+// (an ordinary CI workflow) is silently skipped rather than discovered as
+// a bogus, input-less/output-less action.
+func Discover(root string, opts DiscoverOptions) ([]*Action, error) {
+	var actions []*Action
+	var errs []error
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			rel = path
+		}
+
+		if !matchesFilters(rel, opts) {
+			return nil
+		}
+
+		isAction := isActionFile(path)
+		isWorkflow := !isAction && isWorkflowFile(path)
+		if !isAction && !isWorkflow {
+			return nil
+		}
+
+		action := &Action{InputFileName: path}
+		if err := action.GetData(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+			return nil
+		}
+
+		if isWorkflow && action.Kind != KindReusableWorkflow {
+			return nil
+		}
+
+		actions = append(actions, action)
+
+		return nil
+	})
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return actions, errors.Join(errs...)
+}
+
+func isActionFile(path string) bool {
+	base := filepath.Base(path)
+	return base == "action.yml" || base == "action.yaml"
+}
+
+func isWorkflowFile(path string) bool {
+	base := filepath.Base(path)
+	if !strings.HasSuffix(base, ".yml") && !strings.HasSuffix(base, ".yaml") {
+		return false
+	}
+
+	dir := filepath.Dir(path)
+	return filepath.Base(dir) == "workflows" && filepath.Base(filepath.Dir(dir)) == ".github"
+}
+
+func matchesFilters(rel string, opts DiscoverOptions) bool {
+	if len(opts.Include) > 0 {
+		included := false
+		for _, pattern := range opts.Include {
+			if matchGlob(pattern, rel) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+
+	for _, pattern := range opts.Exclude {
+		if matchGlob(pattern, rel) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchGlob reports whether name matches pattern segment by segment, where a
+// "**" segment matches zero or more path segments (crossing "/") and any
+// other segment is matched with filepath.Match. Plain filepath.Match can't
+// express "**", so a monorepo idiom like "vendor/**" would otherwise never
+// exclude anything past the first path segment.
+func matchGlob(pattern, name string) bool {
+	return matchGlobSegments(strings.Split(pattern, "/"), strings.Split(filepath.ToSlash(name), "/"))
+}
+
+func matchGlobSegments(pattern, name []string) bool {
+	if len(pattern) == 0 {
+		return len(name) == 0
+	}
+
+	if pattern[0] == "**" {
+		if matchGlobSegments(pattern[1:], name) {
+			return true
+		}
+		if len(name) == 0 {
+			return false
+		}
+		return matchGlobSegments(pattern, name[1:])
+	}
+
+	if len(name) == 0 {
+		return false
+	}
+
+	if ok, err := filepath.Match(pattern[0], name[0]); err != nil || !ok {
+		return false
+	}
+
+	return matchGlobSegments(pattern[1:], name[1:])
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDiscoverContinuesPastUnparsableFile(t *testing.T) {
+	dir := t.TempDir()
+
+	badDir := filepath.Join(dir, "bad-action")
+	if err := os.MkdirAll(badDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(badDir, "action.yml"), []byte("inputs: [unterminated"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	goodDir := filepath.Join(dir, "good-action")
+	if err := os.MkdirAll(goodDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(goodDir, "action.yml"), []byte("inputs:\n  foo:\n    description: a foo input\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	actions, err := Discover(dir, DiscoverOptions{})
+	if err == nil {
+		t.Fatal("Discover() with one unparsable action.yml: expected an error, got nil")
+	}
+
+	if len(actions) != 1 {
+		t.Fatalf("Discover() returned %d actions, want 1 (the valid one should still be discovered)", len(actions))
+	}
+	if _, ok := actions[0].Inputs["foo"]; !ok {
+		t.Errorf("expected the valid action's Inputs to contain %q, got %v", "foo", actions[0].Inputs)
+	}
+}
+
+func TestDiscoverSkipsOrdinaryWorkflows(t *testing.T) {
+	dir := t.TempDir()
+
+	workflowsDir := filepath.Join(dir, ".github", "workflows")
+	if err := os.MkdirAll(workflowsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(workflowsDir, "ci.yml"), []byte("on: push\njobs: {}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(workflowsDir, "reusable.yml"), []byte(
+		"on:\n  workflow_call:\n    inputs:\n      foo:\n        description: a foo input\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	actions, err := Discover(dir, DiscoverOptions{})
+	if err != nil {
+		t.Fatalf("Discover() error = %v", err)
+	}
+
+	if len(actions) != 1 {
+		t.Fatalf("Discover() returned %d actions, want 1 (ci.yml has no workflow_call and should be skipped)", len(actions))
+	}
+	if actions[0].InputFileName != filepath.Join(workflowsDir, "reusable.yml") {
+		t.Errorf("Discover() returned %q, want the reusable workflow file", actions[0].InputFileName)
+	}
+}
+
+func TestMatchGlobDoubleStarCrossesPathSeparators(t *testing.T) {
+	if !matchGlob("vendor/**", "vendor/thing/action.yml") {
+		t.Error(`matchGlob("vendor/**", "vendor/thing/action.yml") = false, want true`)
+	}
+	if matchGlob("vendor/**", "other/thing/action.yml") {
+		t.Error(`matchGlob("vendor/**", "other/thing/action.yml") = true, want false`)
+	}
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+// FileKind identifies whether a parsed file is a composite/docker/node
+// action.yml or a reusable workflow file that exposes on.workflow_call.
+type FileKind string
+
+const (
+	KindAction           FileKind = "action"
+	KindReusableWorkflow FileKind = "reusable_workflow"
+)
+
+// DetectFileKind inspects the top-level YAML keys of raw and reports whether
+// it describes an action.yml or a reusable workflow (on.workflow_call). Only a
+// mapping "on" with a workflow_call key counts; a scalar or list "on" (e.g.
+// "on: push") is reported as KindAction.
+func DetectFileKind(raw []byte) (FileKind, error) {
+	var probe struct {
+		On yaml.Node `yaml:"on"`
+	}
+
+	if err := yaml.Unmarshal(raw, &probe); err != nil {
+		return "", err
+	}
+
+	if probe.On.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(probe.On.Content); i += 2 {
+			if probe.On.Content[i].Value == "workflow_call" {
+				return KindReusableWorkflow, nil
+			}
+		}
+	}
+
+	return KindAction, nil
+}
@@ -0,0 +1,39 @@
+package types
+
+import "testing"
+
+func TestDetectFileKind(t *testing.T) {
+	cases := []struct {
+		name string
+		yaml string
+		want FileKind
+	}{
+		{
+			name: "action",
+			yaml: "name: test\ninputs:\n  foo:\n    description: bar\n",
+			want: KindAction,
+		},
+		{
+			name: "reusable workflow",
+			yaml: "on:\n  workflow_call:\n    inputs:\n      foo:\n        description: bar\n",
+			want: KindReusableWorkflow,
+		},
+		{
+			name: "workflow without workflow_call",
+			yaml: "on:\n  push:\n    branches: [main]\n",
+			want: KindAction,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := DetectFileKind([]byte(c.yaml))
+			if err != nil {
+				t.Fatalf("DetectFileKind() error = %v", err)
+			}
+			if got != c.want {
+				t.Errorf("DetectFileKind() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package types
+
+// WorkflowCallInput represents an entry of on.workflow_call.inputs.
+type WorkflowCallInput struct {
+	Description string `yaml:"description"`
+	Required    bool   `yaml:"required"`
+	Default     string `yaml:"default,omitempty"`
+	Type        string `yaml:"type,omitempty"`
+}
+
+// WorkflowCallOutput represents an entry of on.workflow_call.outputs.
+type WorkflowCallOutput struct {
+	Description string `yaml:"description"`
+	Value       string `yaml:"value,omitempty"`
+}
+
+// WorkflowCallSecret represents an entry of on.workflow_call.secrets.
+type WorkflowCallSecret struct {
+	Description string `yaml:"description"`
+	Required    bool   `yaml:"required"`
+}
+
+// WorkflowCall represents on.workflow_call.
+type WorkflowCall struct {
+	Inputs  map[string]WorkflowCallInput  `yaml:"inputs,omitempty"`
+	Outputs map[string]WorkflowCallOutput `yaml:"outputs,omitempty"`
+	Secrets map[string]WorkflowCallSecret `yaml:"secrets,omitempty"`
+}
+
+// WorkflowOn represents the "on" key of a reusable workflow file.
+type WorkflowOn struct {
+	WorkflowCall WorkflowCall `yaml:"workflow_call"`
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UnifiedDiff returns a line-based diff between a and b labelled the way
+// `diff -u` labels its headers, or an empty string when a and b are equal.
+func UnifiedDiff(fromLabel, toLabel, a, b string) string {
+	ops, changed := diffLines(strings.Split(a, "\n"), strings.Split(b, "\n"))
+	if !changed {
+		return ""
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", fromLabel, toLabel)
+	for _, op := range ops {
+		sb.WriteString(op)
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// diffLines computes a minimal line diff using an LCS backtrace, returning
+// "-"/"+"/" " prefixed lines and whether a and b differ at all.
+func diffLines(a, b []string) ([]string, bool) {
+	n, m := len(a), len(b)
+
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var ops []string
+	changed := false
+	i, j := 0, 0
+
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, " "+a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, "-"+a[i])
+			i++
+			changed = true
+		default:
+			ops = append(ops, "+"+b[j])
+			j++
+			changed = true
+		}
+	}
+
+	for ; i < n; i++ {
+		ops = append(ops, "-"+a[i])
+		changed = true
+	}
+
+	for ; j < m; j++ {
+		ops = append(ops, "+"+b[j])
+		changed = true
+	}
+
+	return ops, changed
+}
@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"bytes"
+	"strings"
+)
+
+// HasBytesInBetween reports whether start and end both occur in data, with
+// end occurring after start. It returns the index of the beginning of start
+// and the index immediately following end, suitable for passing straight
+// into ReplaceBytesInBetween.
+func HasBytesInBetween(data, start, end []byte) (bool, int, int) {
+	startIndex := bytes.Index(data, start)
+	if startIndex == -1 {
+		return false, 0, 0
+	}
+
+	relativeEndIndex := bytes.Index(data[startIndex:], end)
+	if relativeEndIndex == -1 {
+		return false, 0, 0
+	}
+
+	endIndex := startIndex + relativeEndIndex + len(end)
+
+	return true, startIndex, endIndex
+}
+
+// ReplaceBytesInBetween replaces data[start:end] with replacement.
+func ReplaceBytesInBetween(data []byte, start, end int, replacement []byte) []byte {
+	output := make([]byte, 0, len(data)-(end-start)+len(replacement))
+	output = append(output, data[:start]...)
+	output = append(output, replacement...)
+	output = append(output, data[end:]...)
+
+	return output
+}
+
+// WordWrap inserts a <br> every limit words so long descriptions don't blow
+// out the width of a rendered table column. A non-positive limit disables
+// wrapping.
+func WordWrap(s string, limit int) string {
+	if limit <= 0 {
+		return s
+	}
+
+	words := strings.Fields(s)
+	if len(words) <= limit {
+		return s
+	}
+
+	var sb strings.Builder
+	for i, word := range words {
+		if i > 0 {
+			if i%limit == 0 {
+				sb.WriteString("<br>")
+			} else {
+				sb.WriteString(" ")
+			}
+		}
+		sb.WriteString(word)
+	}
+
+	return sb.String()
+}